@@ -0,0 +1,53 @@
+package utho
+
+import "testing"
+
+func TestChunkChangesSplitsIntoBoundedBatches(t *testing.T) {
+	changes := make([]*UthoChanges, 5)
+	for i := range changes {
+		changes[i] = &UthoChanges{}
+	}
+
+	chunks := chunkChanges(changes, 2)
+	if len(chunks) != 3 {
+		t.Fatalf("expected 3 chunks, got %d", len(chunks))
+	}
+	if len(chunks[0]) != 2 || len(chunks[1]) != 2 || len(chunks[2]) != 1 {
+		t.Fatalf("unexpected chunk sizes: %v", []int{len(chunks[0]), len(chunks[1]), len(chunks[2])})
+	}
+
+	var total int
+	for _, c := range chunks {
+		total += len(c)
+	}
+	if total != len(changes) {
+		t.Fatalf("expected all %d changes preserved across chunks, got %d", len(changes), total)
+	}
+}
+
+func TestChunkChangesFallsBackToDefaultSize(t *testing.T) {
+	changes := make([]*UthoChanges, defaultBatchChangeSize+1)
+	for i := range changes {
+		changes[i] = &UthoChanges{}
+	}
+
+	chunks := chunkChanges(changes, 0)
+	if len(chunks) != 2 {
+		t.Fatalf("expected chunking with the default batch size to produce 2 chunks, got %d", len(chunks))
+	}
+	if len(chunks[0]) != defaultBatchChangeSize {
+		t.Fatalf("expected first chunk to use defaultBatchChangeSize (%d), got %d", defaultBatchChangeSize, len(chunks[0]))
+	}
+}
+
+func TestBatchChangeSizeFallsBackToDefault(t *testing.T) {
+	p := &UthoProvider{}
+	if got := p.batchChangeSize(); got != defaultBatchChangeSize {
+		t.Fatalf("expected default batch size %d, got %d", defaultBatchChangeSize, got)
+	}
+
+	p.BatchChangeSize = 7
+	if got := p.batchChangeSize(); got != 7 {
+		t.Fatalf("expected configured batch size 7, got %d", got)
+	}
+}
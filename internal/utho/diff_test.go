@@ -0,0 +1,96 @@
+package utho
+
+import (
+	"testing"
+
+	"github.com/uthoplatforms/utho-go/utho"
+)
+
+func TestDiffChangeCreate(t *testing.T) {
+	index := buildRecordIndex(nil)
+	change := &UthoChanges{
+		Action:            uthoCreate,
+		ResourceRecordSet: utho.CreateDnsRecordParams{Hostname: "www", Type: "A", Value: "1.1.1.1", TTL: "300"},
+	}
+
+	diff, err := diffChange(index, change)
+	if err != nil {
+		t.Fatalf("diffChange: %v", err)
+	}
+	if diff.Action != RecordDiffAdded {
+		t.Fatalf("expected %s, got %s", RecordDiffAdded, diff.Action)
+	}
+	if diff.NewValue != "1.1.1.1" || diff.NewTTL != 300 {
+		t.Fatalf("unexpected diff: %+v", diff)
+	}
+	if diff.RecordID != "" {
+		t.Fatalf("expected no record ID for a create, got %q", diff.RecordID)
+	}
+}
+
+func TestDiffChangeDelete(t *testing.T) {
+	index := buildRecordIndex([]utho.DnsRecord{
+		{ID: "1", Hostname: "www", Type: "A", Value: "1.1.1.1", TTL: "300"},
+	})
+	change := &UthoChanges{
+		Action:            uthoDelete,
+		ResourceRecordSet: utho.CreateDnsRecordParams{Hostname: "www", Type: "A", Value: "1.1.1.1"},
+	}
+
+	diff, err := diffChange(index, change)
+	if err != nil {
+		t.Fatalf("diffChange: %v", err)
+	}
+	if diff.Action != RecordDiffRemoved {
+		t.Fatalf("expected %s, got %s", RecordDiffRemoved, diff.Action)
+	}
+	if diff.RecordID != "1" || diff.OldValue != "1.1.1.1" || diff.OldTTL != 300 || diff.NewTTL != 0 {
+		t.Fatalf("unexpected diff: %+v", diff)
+	}
+}
+
+func TestDiffChangeUpdate(t *testing.T) {
+	index := buildRecordIndex([]utho.DnsRecord{
+		{ID: "1", Hostname: "www", Type: "A", Value: "1.1.1.1", TTL: "300"},
+	})
+	change := &UthoChanges{
+		Action:            uthoUpdate,
+		ResourceRecordSet: utho.CreateDnsRecordParams{Hostname: "www", Type: "A", Value: "2.2.2.2", TTL: "600"},
+	}
+
+	diff, err := diffChange(index, change)
+	if err != nil {
+		t.Fatalf("diffChange: %v", err)
+	}
+	if diff.Action != RecordDiffChanged {
+		t.Fatalf("expected %s, got %s", RecordDiffChanged, diff.Action)
+	}
+	if diff.RecordID != "1" || diff.OldValue != "1.1.1.1" || diff.OldTTL != 300 || diff.NewValue != "2.2.2.2" || diff.NewTTL != 600 {
+		t.Fatalf("unexpected diff: %+v", diff)
+	}
+}
+
+func TestDiffChangeUpdateMissingRecordErrors(t *testing.T) {
+	index := buildRecordIndex(nil)
+	change := &UthoChanges{
+		Action:            uthoUpdate,
+		ResourceRecordSet: utho.CreateDnsRecordParams{Hostname: "www", Type: "A", Value: "2.2.2.2"},
+	}
+
+	if _, err := diffChange(index, change); err == nil {
+		t.Fatal("expected an error when the record being updated can't be resolved")
+	}
+}
+
+func TestZoneDiffGroupsRecordsByZone(t *testing.T) {
+	zoneDiff := ZoneDiff{
+		Zone: "example.com",
+		Records: []RecordDiff{
+			{Action: RecordDiffAdded, Hostname: "www", Type: "A", NewValue: "1.1.1.1"},
+		},
+	}
+
+	if zoneDiff.Zone != "example.com" || len(zoneDiff.Records) != 1 {
+		t.Fatalf("unexpected ZoneDiff: %+v", zoneDiff)
+	}
+}
@@ -0,0 +1,94 @@
+package utho
+
+import (
+	"testing"
+
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/plan"
+)
+
+func TestIsSupportedRecordTypeExcludesDNSKEY(t *testing.T) {
+	p := &UthoProvider{DNSSECEnabled: true}
+
+	if !p.isSupportedRecordType("DS") {
+		t.Fatal("expected DS to be supported once DNSSEC is enabled")
+	}
+	if p.isSupportedRecordType("DNSKEY") {
+		t.Fatal("expected DNSKEY to never be surfaced: it has no declarable desired state")
+	}
+}
+
+func TestAdjustEndpointsDropsDSWhenDNSSECDisabled(t *testing.T) {
+	p := &UthoProvider{DNSSECEnabled: false}
+
+	endpoints := []*endpoint.Endpoint{
+		{DNSName: "www.example.com", RecordType: "A", Targets: endpoint.Targets{"1.1.1.1"}},
+		{DNSName: "example.com", RecordType: "DS", Targets: endpoint.Targets{"12345 13 2 abcdef"}},
+	}
+
+	adjusted, err := p.AdjustEndpoints(endpoints)
+	if err != nil {
+		t.Fatalf("AdjustEndpoints: %v", err)
+	}
+	if len(adjusted) != 1 {
+		t.Fatalf("expected the DS endpoint to be dropped, got %d endpoints", len(adjusted))
+	}
+	if adjusted[0].RecordType != "A" {
+		t.Fatalf("expected the surviving endpoint to be the A record, got %s", adjusted[0].RecordType)
+	}
+}
+
+func TestAdjustEndpointsKeepsDSWhenDNSSECEnabled(t *testing.T) {
+	p := &UthoProvider{DNSSECEnabled: true}
+
+	endpoints := []*endpoint.Endpoint{
+		{DNSName: "example.com", RecordType: "DS", Targets: endpoint.Targets{"12345 13 2 abcdef"}},
+	}
+
+	adjusted, err := p.AdjustEndpoints(endpoints)
+	if err != nil {
+		t.Fatalf("AdjustEndpoints: %v", err)
+	}
+	if len(adjusted) != 1 {
+		t.Fatalf("expected the DS endpoint to survive, got %d endpoints", len(adjusted))
+	}
+}
+
+func TestExtractDNSSECChangesSplitsDSEndpoints(t *testing.T) {
+	aRecord := &endpoint.Endpoint{DNSName: "www.example.com", RecordType: "A"}
+	dsCreate := &endpoint.Endpoint{DNSName: "example.com", RecordType: "DS"}
+	dsDelete := &endpoint.Endpoint{DNSName: "example.com", RecordType: "DS"}
+
+	changes := &plan.Changes{
+		Create: []*endpoint.Endpoint{aRecord, dsCreate},
+		Delete: []*endpoint.Endpoint{dsDelete},
+	}
+
+	dnssecChanges, filtered := extractDNSSECChanges(changes)
+
+	if len(dnssecChanges) != 2 {
+		t.Fatalf("expected 2 DNSSEC changes, got %d", len(dnssecChanges))
+	}
+	if len(filtered.Create) != 1 || filtered.Create[0] != aRecord {
+		t.Fatalf("expected the A record to remain in filtered.Create, got %v", filtered.Create)
+	}
+	if len(filtered.Delete) != 0 {
+		t.Fatalf("expected the DS delete to be removed from filtered.Delete, got %v", filtered.Delete)
+	}
+}
+
+func TestApplyDNSSECChangesSkipsWhenDisabled(t *testing.T) {
+	p := &UthoProvider{DNSSECEnabled: false}
+
+	dnssecChanges := []dnssecChange{
+		{action: uthoCreate, endpoint: &endpoint.Endpoint{DNSName: "example.com", RecordType: "DS"}},
+	}
+
+	applied, err := p.applyDNSSECChanges(dnssecChanges, p.zoneIDNameMapper)
+	if err != nil {
+		t.Fatalf("applyDNSSECChanges: %v", err)
+	}
+	if applied {
+		t.Fatal("expected no DNSSEC changes to be applied while disabled")
+	}
+}
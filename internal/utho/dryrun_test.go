@@ -0,0 +1,89 @@
+package utho
+
+import (
+	"context"
+	"testing"
+
+	"github.com/uthoplatforms/utho-go/utho"
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/plan"
+)
+
+// writeRefusingClient is a dnsAPI fake whose read methods serve a fixed
+// in-memory zone so a reconcile can run end-to-end, and whose write methods
+// fail the test immediately if ApplyChanges ever calls them -- proving
+// DryRun actually short-circuits before any record mutation is attempted.
+type writeRefusingClient struct {
+	t       *testing.T
+	domains []utho.Domain
+	records []utho.DnsRecord
+}
+
+func (c *writeRefusingClient) ListDomains() ([]utho.Domain, error) {
+	return c.domains, nil
+}
+
+func (c *writeRefusingClient) ListDnsRecords(domain string) ([]utho.DnsRecord, error) {
+	return c.records, nil
+}
+
+func (c *writeRefusingClient) CreateDnsRecord(rrset utho.CreateDnsRecordParams) error {
+	c.t.Fatalf("CreateDnsRecord called during DryRun: %+v", rrset)
+	return nil
+}
+
+func (c *writeRefusingClient) UpdateDnsRecord(domain, id string, rrset utho.CreateDnsRecordParams) error {
+	c.t.Fatalf("UpdateDnsRecord called during DryRun: domain=%s id=%s rrset=%+v", domain, id, rrset)
+	return nil
+}
+
+func (c *writeRefusingClient) DeleteDnsRecord(domain, id string) error {
+	c.t.Fatalf("DeleteDnsRecord called during DryRun: domain=%s id=%s", domain, id)
+	return nil
+}
+
+func (c *writeRefusingClient) EnableDNSSEC(zone string) error {
+	c.t.Fatalf("EnableDNSSEC called during DryRun: zone=%s", zone)
+	return nil
+}
+
+func (c *writeRefusingClient) DisableDNSSEC(zone string) error {
+	c.t.Fatalf("DisableDNSSEC called during DryRun: zone=%s", zone)
+	return nil
+}
+
+func TestApplyChangesDryRunMakesNoWriteCalls(t *testing.T) {
+	client := &writeRefusingClient{
+		t:       t,
+		domains: []utho.Domain{{Domain: "example.com"}},
+		records: []utho.DnsRecord{
+			{ID: "1", Hostname: "www", Type: "A", Value: "1.1.1.1", TTL: "300"},
+		},
+	}
+
+	p := &UthoProvider{
+		client: client,
+		DryRun: true,
+	}
+
+	changes := &plan.Changes{
+		Create: []*endpoint.Endpoint{
+			{DNSName: "new.example.com", RecordType: "A", Targets: endpoint.Targets{"2.2.2.2"}},
+		},
+		UpdateOld: []*endpoint.Endpoint{
+			{DNSName: "www.example.com", RecordType: "A", Targets: endpoint.Targets{"1.1.1.1"}},
+		},
+		UpdateNew: []*endpoint.Endpoint{
+			{DNSName: "www.example.com", RecordType: "A", Targets: endpoint.Targets{"3.3.3.3"}},
+		},
+	}
+
+	if err := p.ApplyChanges(context.Background(), changes); err != nil {
+		t.Fatalf("ApplyChanges: %v", err)
+	}
+
+	gotPlan := p.LastPlan()
+	if len(gotPlan) != 1 || len(gotPlan[0].Records) != 2 {
+		t.Fatalf("expected a single zone diff with 2 record diffs, got %+v", gotPlan)
+	}
+}
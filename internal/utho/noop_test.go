@@ -0,0 +1,49 @@
+package utho
+
+import (
+	"testing"
+
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/plan"
+	"sigs.k8s.io/external-dns/provider"
+)
+
+func TestHasInScopeChangesDetectsManagedZone(t *testing.T) {
+	zoneIDName := provider.ZoneIDName{}
+	zoneIDName.Add("example.com", "example.com")
+
+	changes := &plan.Changes{
+		Create: []*endpoint.Endpoint{
+			{DNSName: "www.example.com", RecordType: "A"},
+		},
+	}
+
+	if !hasInScopeChanges(zoneIDName, changes) {
+		t.Fatal("expected a change under a managed zone to be in scope")
+	}
+}
+
+func TestHasInScopeChangesSkipsUnmanagedZone(t *testing.T) {
+	zoneIDName := provider.ZoneIDName{}
+	zoneIDName.Add("example.com", "example.com")
+
+	changes := &plan.Changes{
+		Create:    []*endpoint.Endpoint{{DNSName: "www.other.com", RecordType: "A"}},
+		UpdateNew: []*endpoint.Endpoint{{DNSName: "api.other.com", RecordType: "A"}},
+		UpdateOld: []*endpoint.Endpoint{{DNSName: "api.other.com", RecordType: "A"}},
+		Delete:    []*endpoint.Endpoint{{DNSName: "old.other.com", RecordType: "A"}},
+	}
+
+	if hasInScopeChanges(zoneIDName, changes) {
+		t.Fatal("expected a plan touching only unmanaged zones to be out of scope")
+	}
+}
+
+func TestHasInScopeChangesEmptyPlan(t *testing.T) {
+	zoneIDName := provider.ZoneIDName{}
+	zoneIDName.Add("example.com", "example.com")
+
+	if hasInScopeChanges(zoneIDName, &plan.Changes{}) {
+		t.Fatal("expected an empty plan to be out of scope")
+	}
+}
@@ -0,0 +1,126 @@
+package utho
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+//go:embed public_suffix_list.dat
+var embeddedPublicSuffixList string
+
+// pslResolver resolves the public suffix of a hostname from a Public Suffix
+// List snapshot, optionally refreshed from a remote URL on a timer. It
+// replaces the mehrdadep/dex lookup, which re-parsed a PSL snapshot on every
+// call and cached it to the hard-coded path /tmp/list.cache, breaking on
+// read-only filesystems and multi-replica deployments.
+type pslResolver struct {
+	mu    sync.RWMutex
+	rules map[string]struct{}
+}
+
+// defaultPSL is the process-wide resolver, seeded from the embedded
+// snapshot and optionally kept fresh by StartPSLRefresh.
+var defaultPSL = newPSLResolver(embeddedPublicSuffixList)
+
+// newPSLResolver parses a Public Suffix List snapshot in the standard
+// publicsuffix.org list.dat format (one rule per line, "//" comments and
+// blank lines ignored) into a lookup table.
+func newPSLResolver(data string) *pslResolver {
+	r := &pslResolver{}
+	r.load(data)
+	return r
+}
+
+// load replaces the resolver's rule set, safe for concurrent use with
+// Suffix.
+func (r *pslResolver) load(data string) {
+	rules := make(map[string]struct{})
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "//") {
+			continue
+		}
+		rules[line] = struct{}{}
+	}
+
+	r.mu.Lock()
+	r.rules = rules
+	r.mu.Unlock()
+}
+
+// Suffix returns the longest public suffix of fqdn found in the list, or
+// false if none of its labels match a known rule.
+func (r *pslResolver) Suffix(fqdn string) (string, bool) {
+	fqdn = strings.TrimSuffix(strings.ToLower(fqdn), ".")
+	labels := strings.Split(fqdn, ".")
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for i := 0; i < len(labels); i++ {
+		candidate := strings.Join(labels[i:], ".")
+
+		if _, ok := r.rules["!"+candidate]; ok {
+			return strings.Join(labels[i+1:], "."), true
+		}
+		if _, ok := r.rules[candidate]; ok {
+			return candidate, true
+		}
+		if i > 0 {
+			if _, ok := r.rules["*."+candidate]; ok {
+				return strings.Join(labels[i-1:], "."), true
+			}
+		}
+	}
+	return "", false
+}
+
+// refreshFrom fetches a PSL snapshot from url and atomically swaps it in.
+func (r *pslResolver) refreshFrom(url string) error {
+	resp, err := http.Get(url)
+	if err != nil {
+		return fmt.Errorf("fetching public suffix list: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading public suffix list: %w", err)
+	}
+
+	r.load(string(body))
+	return nil
+}
+
+// StartPSLRefresh periodically refreshes the embedded Public Suffix List
+// snapshot from url every interval, in a background goroutine that stops
+// when ctx is cancelled. A zero url or interval disables refreshing and the
+// embedded snapshot is used for the lifetime of the process.
+func StartPSLRefresh(ctx context.Context, url string, interval time.Duration) {
+	if url == "" || interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := defaultPSL.refreshFrom(url); err != nil {
+					log.Errorf("Error refreshing public suffix list: %v", err)
+				}
+			}
+		}
+	}()
+}
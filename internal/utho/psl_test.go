@@ -0,0 +1,73 @@
+package utho
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const testPSLData = `
+// ICANN domains
+com
+co.uk
+*.ck
+!www.ck
+`
+
+func TestPSLResolverSuffix(t *testing.T) {
+	r := newPSLResolver(testPSLData)
+
+	cases := []struct {
+		fqdn   string
+		suffix string
+		ok     bool
+	}{
+		{"example.com", "com", true},
+		{"www.example.co.uk", "co.uk", true},
+		{"foo.ck", "foo.ck", true},
+		{"www.ck", "ck", true},
+		{"example.invalidtld", "", false},
+	}
+
+	for _, c := range cases {
+		suffix, ok := r.Suffix(c.fqdn)
+		if ok != c.ok || suffix != c.suffix {
+			t.Errorf("Suffix(%q) = (%q, %v), want (%q, %v)", c.fqdn, suffix, ok, c.suffix, c.ok)
+		}
+	}
+}
+
+func TestPSLResolverRefreshFrom(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("example-refreshed\n"))
+	}))
+	defer srv.Close()
+
+	r := newPSLResolver(testPSLData)
+	if _, ok := r.Suffix("example-refreshed"); ok {
+		t.Fatal("expected example-refreshed to not be a known suffix before refresh")
+	}
+
+	if err := r.refreshFrom(srv.URL); err != nil {
+		t.Fatalf("refreshFrom: %v", err)
+	}
+
+	if _, ok := r.Suffix("example-refreshed"); !ok {
+		t.Fatal("expected example-refreshed to be a known suffix after refresh")
+	}
+	// The previous snapshot's rules should have been replaced, not merged.
+	if _, ok := r.Suffix("example.com"); ok {
+		t.Fatal("expected the prior snapshot's rules to be replaced by refreshFrom")
+	}
+}
+
+func TestStartPSLRefreshNoopWithoutURL(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Must return immediately without starting a background goroutine.
+	StartPSLRefresh(ctx, "", time.Hour)
+	StartPSLRefresh(ctx, "http://example.invalid", 0)
+}
@@ -0,0 +1,78 @@
+package utho
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/uthoplatforms/utho-go/utho"
+)
+
+func TestTXTValueRoundTrip(t *testing.T) {
+	cases := []string{
+		`v=spf1 include:_spf.google.com ~all`,
+		`short`,
+		strings.Repeat("a", 600) + " with trailing words after a long run",
+	}
+
+	for _, raw := range cases {
+		encoded := encodeTXTValue(raw)
+		decoded := decodeTXTValue(encoded)
+		if decoded != raw {
+			t.Errorf("round trip mismatch: raw=%q encoded=%q decoded=%q", raw, encoded, decoded)
+		}
+	}
+}
+
+func TestMXRoundTrip(t *testing.T) {
+	var rrset utho.CreateDnsRecordParams
+	rrset.Type = "MX"
+	if err := populateRecordParams(&rrset, "MX", "10 mail.example.com"); err != nil {
+		t.Fatalf("populateRecordParams: %v", err)
+	}
+
+	record := utho.DnsRecord{Type: "MX", Priority: rrset.Priority, Value: rrset.Value}
+	got, err := formatRecordValue(record)
+	if err != nil {
+		t.Fatalf("formatRecordValue: %v", err)
+	}
+	if got != "10 mail.example.com" {
+		t.Fatalf("expected %q, got %q", "10 mail.example.com", got)
+	}
+	if got != formatRecordParamsValue(rrset) {
+		t.Fatalf("formatRecordValue/formatRecordParamsValue disagree: %q vs %q", got, formatRecordParamsValue(rrset))
+	}
+}
+
+func TestSRVRoundTrip(t *testing.T) {
+	var rrset utho.CreateDnsRecordParams
+	if err := populateRecordParams(&rrset, "SRV", "10 20 5060 sip.example.com"); err != nil {
+		t.Fatalf("populateRecordParams: %v", err)
+	}
+
+	record := utho.DnsRecord{Type: "SRV", Priority: rrset.Priority, Weight: rrset.Weight, Port: rrset.Port, Value: rrset.Value}
+	got, err := formatRecordValue(record)
+	if err != nil {
+		t.Fatalf("formatRecordValue: %v", err)
+	}
+	want := "10 20 5060 sip.example.com"
+	if got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}
+
+func TestCAARoundTrip(t *testing.T) {
+	var rrset utho.CreateDnsRecordParams
+	if err := populateRecordParams(&rrset, "CAA", `0 issue "letsencrypt.org"`); err != nil {
+		t.Fatalf("populateRecordParams: %v", err)
+	}
+
+	record := utho.DnsRecord{Type: "CAA", Flag: rrset.Flag, Tag: rrset.Tag, Value: rrset.Value}
+	got, err := formatRecordValue(record)
+	if err != nil {
+		t.Fatalf("formatRecordValue: %v", err)
+	}
+	want := `0 issue "letsencrypt.org"`
+	if got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,71 @@
+package utho
+
+import (
+	"testing"
+
+	"github.com/uthoplatforms/utho-go/utho"
+)
+
+func TestLookupRecordDisambiguatesMultiTargetByValue(t *testing.T) {
+	records := []utho.DnsRecord{
+		{ID: "1", Hostname: "www", Type: "A", Value: "1.1.1.1", TTL: "3600"},
+		{ID: "2", Hostname: "www", Type: "A", Value: "2.2.2.2", TTL: "3600"},
+	}
+	index := buildRecordIndex(records)
+
+	// DELETE carries the stored value, so it must resolve to the matching
+	// record even though both share the same hostname+type.
+	deleted, err := lookupRecord(index, utho.CreateDnsRecordParams{Hostname: "www", Type: "A", Value: "2.2.2.2"})
+	if err != nil {
+		t.Fatalf("lookupRecord: %v", err)
+	}
+	if deleted.ID != "2" {
+		t.Fatalf("expected record 2 to be resolved by value, got %s", deleted.ID)
+	}
+
+	remaining, err := lookupRecord(index, utho.CreateDnsRecordParams{Hostname: "www", Type: "A", Value: "1.1.1.1"})
+	if err != nil {
+		t.Fatalf("lookupRecord: %v", err)
+	}
+	if remaining.ID != "1" {
+		t.Fatalf("expected record 1 to remain, got %s", remaining.ID)
+	}
+
+	if _, err := lookupRecord(index, utho.CreateDnsRecordParams{Hostname: "www", Type: "A", Value: "1.1.1.1"}); err == nil {
+		t.Fatal("expected an error once all candidates are consumed")
+	}
+}
+
+func TestLookupRecordFallsBackToPositionalOrderOnUpdate(t *testing.T) {
+	records := []utho.DnsRecord{
+		{ID: "1", Hostname: "www", Type: "A", Value: "1.1.1.1", TTL: "3600"},
+		{ID: "2", Hostname: "www", Type: "A", Value: "2.2.2.2", TTL: "3600"},
+	}
+	index := buildRecordIndex(records)
+
+	// UPDATE carries the new value, which never matches a stored record, so
+	// each call must still resolve to a distinct record rather than reusing
+	// the same one.
+	first, err := lookupRecord(index, utho.CreateDnsRecordParams{Hostname: "www", Type: "A", Value: "3.3.3.3"})
+	if err != nil {
+		t.Fatalf("lookupRecord: %v", err)
+	}
+	second, err := lookupRecord(index, utho.CreateDnsRecordParams{Hostname: "www", Type: "A", Value: "4.4.4.4"})
+	if err != nil {
+		t.Fatalf("lookupRecord: %v", err)
+	}
+
+	if first.ID == second.ID {
+		t.Fatalf("expected two distinct records, both resolved to %s", first.ID)
+	}
+	if first.ID != "1" || second.ID != "2" {
+		t.Fatalf("expected records to be consumed in ListDnsRecords order, got %s then %s", first.ID, second.ID)
+	}
+}
+
+func TestLookupRecordNoMatch(t *testing.T) {
+	index := buildRecordIndex(nil)
+	if _, err := lookupRecord(index, utho.CreateDnsRecordParams{Hostname: "www", Type: "A", Value: "1.1.1.1"}); err == nil {
+		t.Fatal("expected an error when no record is indexed")
+	}
+}
@@ -0,0 +1,110 @@
+package utho
+
+import (
+	"testing"
+
+	"sigs.k8s.io/external-dns/endpoint"
+)
+
+func TestNewUpdateChangesSameTargetCount(t *testing.T) {
+	old := []*endpoint.Endpoint{
+		{DNSName: "www.example.com", RecordType: "A", Targets: endpoint.Targets{"1.1.1.1", "2.2.2.2"}},
+	}
+	newEps := []*endpoint.Endpoint{
+		{DNSName: "www.example.com", RecordType: "A", Targets: endpoint.Targets{"3.3.3.3", "4.4.4.4"}},
+	}
+
+	changes, err := newUpdateChanges(old, newEps)
+	if err != nil {
+		t.Fatalf("newUpdateChanges: %v", err)
+	}
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 UPDATE changes, got %d", len(changes))
+	}
+	for _, c := range changes {
+		if c.Action != uthoUpdate {
+			t.Fatalf("expected all changes to be UPDATE, got %s", c.Action)
+		}
+	}
+}
+
+func TestNewUpdateChangesShrinkDeletesOrphanedTarget(t *testing.T) {
+	old := []*endpoint.Endpoint{
+		{DNSName: "www.example.com", RecordType: "A", Targets: endpoint.Targets{"1.1.1.1", "2.2.2.2"}},
+	}
+	newEps := []*endpoint.Endpoint{
+		{DNSName: "www.example.com", RecordType: "A", Targets: endpoint.Targets{"3.3.3.3"}},
+	}
+
+	changes, err := newUpdateChanges(old, newEps)
+	if err != nil {
+		t.Fatalf("newUpdateChanges: %v", err)
+	}
+
+	var updates, deletes int
+	for _, c := range changes {
+		switch c.Action {
+		case uthoUpdate:
+			updates++
+			if c.ResourceRecordSet.Value != "3.3.3.3" {
+				t.Fatalf("expected the update to carry the new value, got %s", c.ResourceRecordSet.Value)
+			}
+		case uthoDelete:
+			deletes++
+			if c.ResourceRecordSet.Value != "2.2.2.2" {
+				t.Fatalf("expected the delete to carry the orphaned old value, got %s", c.ResourceRecordSet.Value)
+			}
+		default:
+			t.Fatalf("unexpected action %s", c.Action)
+		}
+	}
+	if updates != 1 || deletes != 1 {
+		t.Fatalf("expected 1 UPDATE and 1 DELETE, got %d UPDATE and %d DELETE", updates, deletes)
+	}
+}
+
+func TestNewUpdateChangesGrowCreatesExtraTarget(t *testing.T) {
+	old := []*endpoint.Endpoint{
+		{DNSName: "www.example.com", RecordType: "A", Targets: endpoint.Targets{"1.1.1.1"}},
+	}
+	newEps := []*endpoint.Endpoint{
+		{DNSName: "www.example.com", RecordType: "A", Targets: endpoint.Targets{"2.2.2.2", "3.3.3.3"}},
+	}
+
+	changes, err := newUpdateChanges(old, newEps)
+	if err != nil {
+		t.Fatalf("newUpdateChanges: %v", err)
+	}
+
+	var updates, creates int
+	for _, c := range changes {
+		switch c.Action {
+		case uthoUpdate:
+			updates++
+			if c.ResourceRecordSet.Value != "2.2.2.2" {
+				t.Fatalf("expected the update to carry the first new value, got %s", c.ResourceRecordSet.Value)
+			}
+		case uthoCreate:
+			creates++
+			if c.ResourceRecordSet.Value != "3.3.3.3" {
+				t.Fatalf("expected the create to carry the extra new value, got %s", c.ResourceRecordSet.Value)
+			}
+		default:
+			t.Fatalf("unexpected action %s", c.Action)
+		}
+	}
+	if updates != 1 || creates != 1 {
+		t.Fatalf("expected 1 UPDATE and 1 CREATE, got %d UPDATE and %d CREATE", updates, creates)
+	}
+}
+
+func TestNewUpdateChangesLengthMismatchErrors(t *testing.T) {
+	old := []*endpoint.Endpoint{
+		{DNSName: "www.example.com", RecordType: "A", Targets: endpoint.Targets{"1.1.1.1"}},
+	}
+	newEps := []*endpoint.Endpoint{}
+
+	if _, err := newUpdateChanges(old, newEps); err == nil {
+		t.Fatal("expected an error when UpdateOld/UpdateNew lengths don't match")
+	}
+}
@@ -6,8 +6,11 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
-	"github.com/mehrdadep/dex"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	log "github.com/sirupsen/logrus"
 	"github.com/uthoplatforms/utho-go/utho"
 	"sigs.k8s.io/external-dns/endpoint"
@@ -15,21 +18,147 @@ import (
 	"sigs.k8s.io/external-dns/provider"
 )
 
+// zoneCacheTTL bounds how long a fetched zone list is reused across
+// ApplyChanges calls before Zones() is hit again.
+const zoneCacheTTL = 5 * time.Minute
+
+// noopRunsTotal counts reconciles skipped because the plan had no in-scope
+// changes, or because min-sync-interval had not yet elapsed.
+var noopRunsTotal = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "utho_provider_noop_runs_total",
+	Help: "Number of ApplyChanges reconciles skipped before any DNS record was listed or written.",
+})
+
 const (
 	uthoCreate = "CREATE"
 	uthoDelete = "DELETE"
 	uthoUpdate = "UPDATE"
 	uthoTTL    = 3600
+
+	// defaultBatchChangeSize bounds how many changes are submitted to a zone
+	// in one pass when Configuration.BatchChangeSize is unset.
+	defaultBatchChangeSize = 100
 )
 
+// dnsAPI is the subset of the Utho client's domain-scoped API this provider
+// calls, narrowed to an interface (rather than depending on utho.Client
+// directly) so tests can substitute a fake without reproducing utho-go's
+// exact method signatures -- notably the write methods' first return value,
+// which every call site here discards.
+type dnsAPI interface {
+	ListDomains() ([]utho.Domain, error)
+	ListDnsRecords(domain string) ([]utho.DnsRecord, error)
+	CreateDnsRecord(rrset utho.CreateDnsRecordParams) error
+	UpdateDnsRecord(domain, id string, rrset utho.CreateDnsRecordParams) error
+	DeleteDnsRecord(domain, id string) error
+	EnableDNSSEC(zone string) error
+	DisableDNSSEC(zone string) error
+}
+
+// uthoDomainAPI adapts utho.Client's Domain() service to dnsAPI, dropping
+// the write methods' unused first return value.
+type uthoDomainAPI struct {
+	client utho.Client
+}
+
+func (a uthoDomainAPI) ListDomains() ([]utho.Domain, error) {
+	return a.client.Domain().ListDomains()
+}
+
+func (a uthoDomainAPI) ListDnsRecords(domain string) ([]utho.DnsRecord, error) {
+	return a.client.Domain().ListDnsRecords(domain)
+}
+
+func (a uthoDomainAPI) CreateDnsRecord(rrset utho.CreateDnsRecordParams) error {
+	_, err := a.client.Domain().CreateDnsRecord(rrset)
+	return err
+}
+
+func (a uthoDomainAPI) UpdateDnsRecord(domain, id string, rrset utho.CreateDnsRecordParams) error {
+	_, err := a.client.Domain().UpdateDnsRecord(domain, id, rrset)
+	return err
+}
+
+func (a uthoDomainAPI) DeleteDnsRecord(domain, id string) error {
+	_, err := a.client.Domain().DeleteDnsRecord(domain, id)
+	return err
+}
+
+func (a uthoDomainAPI) EnableDNSSEC(zone string) error {
+	_, err := a.client.Domain().EnableDNSSEC(zone)
+	return err
+}
+
+func (a uthoDomainAPI) DisableDNSSEC(zone string) error {
+	_, err := a.client.Domain().DisableDNSSEC(zone)
+	return err
+}
+
 // UthoProvider is the main provider structure implementing the provider interface.
 type UthoProvider struct {
 	provider.BaseProvider
-	client utho.Client
+	client dnsAPI
 
 	zoneIDNameMapper provider.ZoneIDName
 	domainFilter     endpoint.DomainFilter
 	DryRun           bool
+	BatchChangeSize  int
+	BatchInterval    time.Duration
+	MinSyncInterval  time.Duration
+	DNSSECEnabled    bool
+
+	zoneCacheMu sync.Mutex
+	zoneCache   []utho.Domain
+	zoneCacheAt time.Time
+	lastSyncMu  sync.Mutex
+	lastSyncAt  time.Time
+
+	lastPlanMu sync.Mutex
+	lastPlan   []ZoneDiff
+}
+
+// RecordDiffAction identifies how a record would change in a dry run.
+type RecordDiffAction string
+
+const (
+	RecordDiffAdded   RecordDiffAction = "ADDED"
+	RecordDiffRemoved RecordDiffAction = "REMOVED"
+	RecordDiffChanged RecordDiffAction = "CHANGED"
+)
+
+// RecordDiff describes a single record-level change that submitChanges would
+// have made, had DryRun not been set.
+type RecordDiff struct {
+	Action   RecordDiffAction
+	RecordID string
+	Hostname string
+	Type     string
+	OldValue string
+	NewValue string
+	OldTTL   int
+	NewTTL   int
+}
+
+// ZoneDiff groups the RecordDiffs that a dry run computed for a single zone.
+type ZoneDiff struct {
+	Zone    string
+	Records []RecordDiff
+}
+
+// LastPlan returns the structured diff computed by the most recent dry-run
+// reconcile, for tests and for a future /plan HTTP endpoint on the webhook.
+// It returns nil when DryRun is disabled or ApplyChanges has not run yet.
+func (p *UthoProvider) LastPlan() []ZoneDiff {
+	p.lastPlanMu.Lock()
+	defer p.lastPlanMu.Unlock()
+	return p.lastPlan
+}
+
+// setLastPlan stores the diff computed by a dry-run reconcile.
+func (p *UthoProvider) setLastPlan(plan []ZoneDiff) {
+	p.lastPlanMu.Lock()
+	defer p.lastPlanMu.Unlock()
+	p.lastPlan = plan
 }
 
 // UthoChanges represents a change (CREATE, UPDATE, DELETE) to DNS records.
@@ -41,12 +170,18 @@ type UthoChanges struct {
 
 // Configuration contains the Utho provider's configuration details.
 type Configuration struct {
-	APIKey               string   `env:"UTHO_API_KEY" required:"true"`
-	DryRun               bool     `env:"DRY_RUN" default:"false"`
-	DomainFilter         []string `env:"DOMAIN_FILTER" default:""`
-	ExcludeDomains       []string `env:"EXCLUDE_DOMAIN_FILTER" default:""`
-	RegexDomainFilter    string   `env:"REGEXP_DOMAIN_FILTER" default:""`
-	RegexDomainExclusion string   `env:"REGEXP_DOMAIN_FILTER_EXCLUSION" default:""`
+	APIKey               string        `env:"UTHO_API_KEY" required:"true"`
+	DryRun               bool          `env:"DRY_RUN" default:"false"`
+	BatchChangeSize      int           `env:"BATCH_CHANGE_SIZE" default:"100"`
+	BatchInterval        time.Duration `env:"BATCH_INTERVAL" default:"0s"`
+	MinSyncInterval      time.Duration `env:"MIN_SYNC_INTERVAL" default:"0s"`
+	DNSSECEnabled        bool          `env:"UTHO_ENABLE_DNSSEC" default:"false"`
+	PSLRefreshURL        string        `env:"PSL_REFRESH_URL" default:""`
+	PSLRefreshInterval   time.Duration `env:"PSL_REFRESH_INTERVAL" default:"24h"`
+	DomainFilter         []string      `env:"DOMAIN_FILTER" default:""`
+	ExcludeDomains       []string      `env:"EXCLUDE_DOMAIN_FILTER" default:""`
+	RegexDomainFilter    string        `env:"REGEXP_DOMAIN_FILTER" default:""`
+	RegexDomainExclusion string        `env:"REGEXP_DOMAIN_FILTER_EXCLUSION" default:""`
 }
 
 // NewProvider initializes a new instance of UthoProvider with the given configuration.
@@ -54,13 +189,113 @@ func NewProvider(providerConfig *Configuration) (*UthoProvider, error) {
 	log.Infof("Creating new provider with API key: %s", providerConfig.APIKey)
 	uthoClient, _ := utho.NewClient(providerConfig.APIKey)
 
+	// A PSL refresh URL opts the process into periodically refreshing the
+	// embedded Public Suffix List snapshot for the remainder of its
+	// lifetime; leaving it unset keeps the embedded snapshot in place, as
+	// before.
+	StartPSLRefresh(context.Background(), providerConfig.PSLRefreshURL, providerConfig.PSLRefreshInterval)
+
 	return &UthoProvider{
-		client:       uthoClient,
-		DryRun:       providerConfig.DryRun,
-		domainFilter: GetDomainFilter(*providerConfig),
+		client:          uthoDomainAPI{client: uthoClient},
+		DryRun:          providerConfig.DryRun,
+		BatchChangeSize: providerConfig.BatchChangeSize,
+		BatchInterval:   providerConfig.BatchInterval,
+		MinSyncInterval: providerConfig.MinSyncInterval,
+		DNSSECEnabled:   providerConfig.DNSSECEnabled,
+		domainFilter:    GetDomainFilter(*providerConfig),
 	}, nil
 }
 
+// withinMinSyncInterval reports whether the configured MinSyncInterval has
+// not yet elapsed since the last successful reconcile.
+func (p *UthoProvider) withinMinSyncInterval() bool {
+	if p.MinSyncInterval <= 0 {
+		return false
+	}
+
+	p.lastSyncMu.Lock()
+	defer p.lastSyncMu.Unlock()
+	return !p.lastSyncAt.IsZero() && time.Since(p.lastSyncAt) < p.MinSyncInterval
+}
+
+// markSynced records that a reconcile was just performed, for
+// withinMinSyncInterval to rate-limit future calls against.
+func (p *UthoProvider) markSynced() {
+	p.lastSyncMu.Lock()
+	defer p.lastSyncMu.Unlock()
+	p.lastSyncAt = time.Now()
+}
+
+// cachedZones returns the zone list, refreshing it from the API only once
+// per zoneCacheTTL so repeated ApplyChanges calls don't each pay for a
+// Zones() round trip.
+func (p *UthoProvider) cachedZones(ctx context.Context) ([]utho.Domain, error) {
+	p.zoneCacheMu.Lock()
+	defer p.zoneCacheMu.Unlock()
+
+	if p.zoneCache != nil && time.Since(p.zoneCacheAt) < zoneCacheTTL {
+		return p.zoneCache, nil
+	}
+
+	zones, err := p.Zones(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p.zoneCache = zones
+	p.zoneCacheAt = time.Now()
+	return zones, nil
+}
+
+// hasInScopeChanges reports whether any endpoint in changes falls under a
+// known Utho zone, so a plan that touches none of our zones can be skipped
+// before issuing any further API calls.
+func hasInScopeChanges(zoneIDName provider.ZoneIDName, changes *plan.Changes) bool {
+	for _, endpoints := range [][]*endpoint.Endpoint{changes.Create, changes.UpdateNew, changes.UpdateOld, changes.Delete} {
+		for _, e := range endpoints {
+			if _, zone := zoneIDName.FindZone(e.DNSName); zone != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// splitHostname splits fqdn into the subdomain portion relative to zone
+// (using "@" to denote the zone apex, matching the Utho API convention) and
+// the zone apex itself. It returns an error when fqdn does not fall under
+// zone, or when zone is itself a public suffix rather than a registrable
+// domain, mirroring the "return an error when extracting record name"
+// pattern used by other DNS providers.
+func (p *UthoProvider) splitHostname(fqdn, zone string) (sub, apex string, err error) {
+	fqdn = strings.TrimSuffix(fqdn, ".")
+	zone = strings.TrimSuffix(zone, ".")
+
+	if suffix, ok := defaultPSL.Suffix(zone); ok && suffix == zone {
+		return "", "", fmt.Errorf("zone %q is a public suffix, not a registrable domain", zone)
+	}
+
+	if fqdn == zone {
+		return "@", zone, nil
+	}
+
+	suffix := "." + zone
+	if !strings.HasSuffix(fqdn, suffix) {
+		return "", "", fmt.Errorf("hostname %q does not fall under zone %q", fqdn, zone)
+	}
+
+	return strings.TrimSuffix(fqdn, suffix), zone, nil
+}
+
+// batchChangeSize returns the configured batch size, falling back to
+// defaultBatchChangeSize when unset.
+func (p *UthoProvider) batchChangeSize() int {
+	if p.BatchChangeSize > 0 {
+		return p.BatchChangeSize
+	}
+	return defaultBatchChangeSize
+}
+
 // Zones returns a list of hosted zones.
 func (p *UthoProvider) Zones(ctx context.Context) ([]utho.Domain, error) {
 	log.Info("Fetching zones")
@@ -74,6 +309,26 @@ func (p *UthoProvider) Zones(ctx context.Context) ([]utho.Domain, error) {
 	return zones, nil
 }
 
+// isSupportedRecordType reports whether a record type should be surfaced by
+// Records(), extending provider.SupportedRecordType with "DS" when DNSSEC
+// support is enabled, so DS records only appear once the operator has
+// opted in via UTHO_ENABLE_DNSSEC.
+//
+// DNSKEY is deliberately NOT surfaced here, even though it's DNSSEC-related
+// like DS: a DS record is parent-zone-facing state a registrar publishes on
+// the zone owner's behalf, but a DNSKEY record is generated by the DNS host
+// itself as a side effect of EnableDNSSEC, not something external-dns's
+// Create/Update/Delete model can represent as declarable desired state --
+// there's no target string a user could supply for the provider to act on.
+// Surfacing it as current state with nothing in desired state to match it
+// would just make the planner propose deleting it every reconcile.
+func (p *UthoProvider) isSupportedRecordType(recordType string) bool {
+	if recordType == "DS" {
+		return p.DNSSECEnabled
+	}
+	return provider.SupportedRecordType(recordType)
+}
+
 // Records retrieves the list of DNS records for all zones.
 func (p *UthoProvider) Records(ctx context.Context) ([]*endpoint.Endpoint, error) {
 	log.Info("Fetching records")
@@ -87,31 +342,58 @@ func (p *UthoProvider) Records(ctx context.Context) ([]*endpoint.Endpoint, error
 
 	for _, zone := range zones {
 		log.Infof("Fetching records for zone: %s", zone.Domain)
-		records, err := p.client.Domain().ListDnsRecords(zone.Domain)
+		records, err := p.client.ListDnsRecords(zone.Domain)
 		if err != nil {
 			log.Errorf("Error fetching records for zone %s: %v", zone.Domain, err)
 			return nil, err
 		}
 
+		// Records of the same name/type are merged into a single endpoint so
+		// that multi-value record sets (e.g. several A targets) round-trip
+		// through a single Endpoint rather than being split into duplicates.
+		type recordKey struct{ name, recordType string }
+		byKey := map[recordKey]*endpoint.Endpoint{}
+		var order []recordKey
+
 		for _, r := range records {
 			log.Debugf("Processing record: %+v", r)
 			// Check if the record type is supported before processing it.
-			if provider.SupportedRecordType(r.Type) {
-				name := fmt.Sprintf("%s.%s", r.Hostname, zone.Domain)
+			if !p.isSupportedRecordType(r.Type) {
+				continue
+			}
 
-				// Handle cases where hostname is empty or denotes the root domain.
-				if (r.Hostname == "" || r.Hostname == "@") && zone.Domain != "" {
-					name = zone.Domain
-				}
+			name := fmt.Sprintf("%s.%s", r.Hostname, zone.Domain)
 
-				parsedTTL, err := strconv.Atoi(r.TTL)
-				if err != nil {
-					log.Errorf("Invalid TTL value: %s, error: %v", r.TTL, err)
-					return nil, fmt.Errorf("invalid TTL value: %w", err)
-				}
-				endpoints = append(endpoints,
-					endpoint.NewEndpointWithTTL(name, r.Type, endpoint.TTL(int64(parsedTTL)), r.Value))
+			// Handle cases where hostname is empty or denotes the root domain.
+			if (r.Hostname == "" || r.Hostname == "@") && zone.Domain != "" {
+				name = zone.Domain
 			}
+
+			parsedTTL, err := strconv.Atoi(r.TTL)
+			if err != nil {
+				log.Errorf("Invalid TTL value: %s, error: %v", r.TTL, err)
+				return nil, fmt.Errorf("invalid TTL value: %w", err)
+			}
+
+			value, err := formatRecordValue(r)
+			if err != nil {
+				log.Errorf("Error formatting record value for %s %s: %v", name, r.Type, err)
+				return nil, err
+			}
+
+			key := recordKey{name: name, recordType: r.Type}
+			if ep, ok := byKey[key]; ok {
+				ep.Targets = append(ep.Targets, value)
+				continue
+			}
+
+			ep := endpoint.NewEndpointWithTTL(name, r.Type, endpoint.TTL(int64(parsedTTL)), value)
+			byKey[key] = ep
+			order = append(order, key)
+		}
+
+		for _, key := range order {
+			endpoints = append(endpoints, byKey[key])
 		}
 	}
 
@@ -119,10 +401,46 @@ func (p *UthoProvider) Records(ctx context.Context) ([]*endpoint.Endpoint, error
 	return endpoints, nil
 }
 
+// formatRecordValue renders a fetched DNS record back into the target string
+// form external-dns expects for its record type, reversing the splitting
+// done in newUthoChanges for MX, SRV, CAA and TXT records.
+func formatRecordValue(r utho.DnsRecord) (string, error) {
+	switch r.Type {
+	case endpoint.RecordTypeMX:
+		return fmt.Sprintf("%s %s", r.Priority, r.Value), nil
+	case "SRV":
+		return fmt.Sprintf("%s %s %s %s", r.Priority, r.Weight, r.Port, r.Value), nil
+	case "CAA":
+		return fmt.Sprintf("%s %s %q", r.Flag, r.Tag, r.Value), nil
+	case endpoint.RecordTypeTXT:
+		return decodeTXTValue(r.Value), nil
+	default:
+		return r.Value, nil
+	}
+}
+
+// formatRecordParamsValue renders rrset's value the same way
+// formatRecordValue does for an existing DnsRecord, so a dry-run diff can
+// show old and new values in the same format.
+func formatRecordParamsValue(rrset utho.CreateDnsRecordParams) string {
+	switch rrset.Type {
+	case endpoint.RecordTypeMX:
+		return fmt.Sprintf("%s %s", rrset.Priority, rrset.Value)
+	case "SRV":
+		return fmt.Sprintf("%s %s %s %s", rrset.Priority, rrset.Weight, rrset.Port, rrset.Value)
+	case "CAA":
+		return fmt.Sprintf("%s %s %q", rrset.Flag, rrset.Tag, rrset.Value)
+	case endpoint.RecordTypeTXT:
+		return decodeTXTValue(rrset.Value)
+	default:
+		return rrset.Value
+	}
+}
+
 // fetchRecords retrieves DNS records for a specific domain.
 func (p *UthoProvider) fetchRecords(domain string) ([]utho.DnsRecord, error) {
 	log.Infof("Fetching records for domain: %s", domain)
-	records, err := p.client.Domain().ListDnsRecords(domain)
+	records, err := p.client.ListDnsRecords(domain)
 	if err != nil {
 		log.Errorf("Error fetching records for domain %s: %v", domain, err)
 		return nil, err
@@ -132,12 +450,120 @@ func (p *UthoProvider) fetchRecords(domain string) ([]utho.DnsRecord, error) {
 	return records, nil
 }
 
+// EnableDNSSEC turns on DNSSEC signing for zone through the Utho registrar
+// API. It is triggered by ApplyChanges when a plan creates a DS-typed
+// endpoint, rather than by a normal DNS record write.
+func (p *UthoProvider) EnableDNSSEC(zone string) error {
+	log.Infof("Enabling DNSSEC for zone: %s", zone)
+	if err := p.client.EnableDNSSEC(zone); err != nil {
+		log.Errorf("Error enabling DNSSEC for zone %s: %v", zone, err)
+		return err
+	}
+	return nil
+}
+
+// DisableDNSSEC turns off DNSSEC signing for zone through the Utho
+// registrar API. It is triggered by ApplyChanges when a plan deletes a
+// DS-typed endpoint.
+func (p *UthoProvider) DisableDNSSEC(zone string) error {
+	log.Infof("Disabling DNSSEC for zone: %s", zone)
+	if err := p.client.DisableDNSSEC(zone); err != nil {
+		log.Errorf("Error disabling DNSSEC for zone %s: %v", zone, err)
+		return err
+	}
+	return nil
+}
+
+// dnssecChange records a pending DS-typed endpoint pulled out of a plan so
+// it can be routed to the registrar API instead of the normal record path.
+type dnssecChange struct {
+	action   string
+	endpoint *endpoint.Endpoint
+}
+
+// extractDNSSECChanges splits DS-typed endpoints out of changes, returning
+// them separately and a copy of changes with the DS entries removed so the
+// normal record-write path never sees them.
+func extractDNSSECChanges(changes *plan.Changes) ([]dnssecChange, *plan.Changes) {
+	var dnssecChanges []dnssecChange
+
+	filtered := &plan.Changes{
+		Create:    make([]*endpoint.Endpoint, 0, len(changes.Create)),
+		UpdateNew: make([]*endpoint.Endpoint, 0, len(changes.UpdateNew)),
+		UpdateOld: make([]*endpoint.Endpoint, 0, len(changes.UpdateOld)),
+		Delete:    make([]*endpoint.Endpoint, 0, len(changes.Delete)),
+	}
+
+	for _, e := range changes.Create {
+		if e.RecordType == "DS" {
+			dnssecChanges = append(dnssecChanges, dnssecChange{uthoCreate, e})
+			continue
+		}
+		filtered.Create = append(filtered.Create, e)
+	}
+	for _, e := range changes.UpdateNew {
+		if e.RecordType == "DS" {
+			dnssecChanges = append(dnssecChanges, dnssecChange{uthoUpdate, e})
+			continue
+		}
+		filtered.UpdateNew = append(filtered.UpdateNew, e)
+	}
+	for _, e := range changes.UpdateOld {
+		if e.RecordType != "DS" {
+			filtered.UpdateOld = append(filtered.UpdateOld, e)
+		}
+	}
+	for _, e := range changes.Delete {
+		if e.RecordType == "DS" {
+			dnssecChanges = append(dnssecChanges, dnssecChange{uthoDelete, e})
+			continue
+		}
+		filtered.Delete = append(filtered.Delete, e)
+	}
+
+	return dnssecChanges, filtered
+}
+
+// applyDNSSECChanges drives the registrar DNSSEC API for any DS-typed
+// endpoints pulled out of the plan, returning whether any were applied.
+func (p *UthoProvider) applyDNSSECChanges(dnssecChanges []dnssecChange, zoneIDName provider.ZoneIDName) (bool, error) {
+	if len(dnssecChanges) == 0 {
+		return false, nil
+	}
+
+	if !p.DNSSECEnabled {
+		log.Warnf("Plan contains %d DS-typed endpoint(s) but DNSSEC support is disabled (UTHO_ENABLE_DNSSEC); skipping", len(dnssecChanges))
+		return false, nil
+	}
+
+	applied := false
+	for _, c := range dnssecChanges {
+		_, zone := zoneIDName.FindZone(c.endpoint.DNSName)
+		if zone == "" {
+			log.Debugf("Skipping DS endpoint %s: no matching zone", c.endpoint.DNSName)
+			continue
+		}
+
+		var err error
+		if c.action == uthoDelete {
+			err = p.DisableDNSSEC(zone)
+		} else {
+			err = p.EnableDNSSEC(zone)
+		}
+		if err != nil {
+			return applied, err
+		}
+		applied = true
+	}
+	return applied, nil
+}
+
 // fetchZones retrieves all zones managed by the provider and filters them using the domain filter.
 func (p *UthoProvider) fetchZones() ([]utho.Domain, error) {
 	log.Info("Fetching all domains")
 	var zones []utho.Domain
 
-	allDomains, err := p.client.Domain().ListDomains()
+	allDomains, err := p.client.ListDomains()
 	if err != nil {
 		log.Errorf("Error fetching all domains: %v", err)
 		return nil, err
@@ -155,127 +581,567 @@ func (p *UthoProvider) fetchZones() ([]utho.Domain, error) {
 }
 
 // submitChanges processes DNS changes such as CREATE, UPDATE, or DELETE actions.
-func (p *UthoProvider) submitChanges(ctx context.Context, changes []*UthoChanges) error {
+// When p.DryRun is set, no CreateDnsRecord/DeleteDnsRecord/UpdateDnsRecord
+// call is made; instead the changes are recorded as a structured diff
+// retrievable via LastPlan.
+func (p *UthoProvider) submitChanges(ctx context.Context, zones []utho.Domain, changes []*UthoChanges) error {
 	log.Infof("Submitting changes: %v", changes)
 	if len(changes) == 0 {
 		log.Infof("No changes to submit")
 		return nil
 	}
 
-	zones, err := p.Zones(ctx)
-	if err != nil {
-		log.Errorf("Error fetching zones during submit: %v", err)
-		return err
-	}
-
 	zoneChanges := separateChangesByZone(zones, changes)
-	cache := "/tmp/list.cache"
-	extract, _ := dex.New(cache)
+	var zoneDiffs []ZoneDiff
 
 	for zoneName, changes := range zoneChanges {
+		// Nothing to do for this zone: skip it without ever listing its
+		// records, so a no-op reconcile doesn't cost an API call per zone.
+		if len(changes) == 0 {
+			log.Debugf("No changes for zone %s, skipping", zoneName)
+			continue
+		}
+
 		log.Infof("Processing changes for zone: %s", zoneName)
-		for _, change := range changes {
-			log.WithFields(log.Fields{
-				"record": change.ResourceRecordSet.Hostname,
-				"type":   change.ResourceRecordSet.Type,
-				"ttl":    change.ResourceRecordSet.TTL,
-				"action": change.Action,
-				"zone":   zoneName,
-			}).Info("Processing change")
-
-			change.ResourceRecordSet.Domain = zoneName
-
-			// record on the apex domain
-			if change.ResourceRecordSet.Hostname == zoneName {
-				change.ResourceRecordSet.Hostname = "@"
-			} else {
-				change.ResourceRecordSet.Hostname = extract.Parse(change.ResourceRecordSet.Hostname).Subdomain
-			}
 
-			// Perform the required action (CREATE, UPDATE, DELETE).
-			switch change.Action {
-			case uthoCreate:
-				log.Infof("Creating record: %+v", change.ResourceRecordSet)
-				if _, err := p.client.Domain().CreateDnsRecord(change.ResourceRecordSet); err != nil {
-					log.Errorf("Error creating record: %v", err)
-					return err
-				}
-			case uthoDelete:
-				log.Infof("Deleting record: %+v", change.ResourceRecordSet)
-				id, err := p.getRecordID(zoneName, change.ResourceRecordSet)
+		// List the zone's records once and reuse the lookup for every change
+		// in the zone, rather than re-listing per change.
+		records, err := p.client.ListDnsRecords(zoneName)
+		if err != nil {
+			log.Errorf("Error listing records for zone %s: %v", zoneName, err)
+			return err
+		}
+		recordIndex := buildRecordIndex(records)
+		var zoneDiff ZoneDiff
+		if p.DryRun {
+			zoneDiff = ZoneDiff{Zone: zoneName}
+		}
+
+		batches := chunkChanges(changes, p.batchChangeSize())
+		for i, batch := range batches {
+			for _, change := range batch {
+				log.WithFields(log.Fields{
+					"record": change.ResourceRecordSet.Hostname,
+					"type":   change.ResourceRecordSet.Type,
+					"ttl":    change.ResourceRecordSet.TTL,
+					"action": change.Action,
+					"zone":   zoneName,
+				}).Info("Processing change")
+
+				change.ResourceRecordSet.Domain = zoneName
+
+				sub, _, err := p.splitHostname(change.ResourceRecordSet.Hostname, zoneName)
 				if err != nil {
-					log.Errorf("Error getting record ID: %v", err)
+					log.Errorf("Error splitting hostname: %v", err)
 					return err
 				}
+				change.ResourceRecordSet.Hostname = sub
 
-				if _, err := p.client.Domain().DeleteDnsRecord(zoneName, id); err != nil {
-					log.Errorf("Error deleting record: %v", err)
-					return err
-				}
-			case uthoUpdate:
-				log.Infof("Updating record: %+v", change.ResourceRecordSet)
-				id, err := p.getRecordID(zoneName, change.ResourceRecordSet)
-				if err != nil {
-					log.Errorf("Error getting record ID for update: %v", err)
-					return err
+				if p.DryRun {
+					diff, err := diffChange(recordIndex, change)
+					if err != nil {
+						log.Errorf("Error computing dry-run diff: %v", err)
+						return err
+					}
+					zoneDiff.Records = append(zoneDiff.Records, diff)
+					continue
 				}
 
-				// Delete the old record before creating the updated one.
-				log.Infof("Deleting old record for update: ID=%s", id)
-				if _, err := p.client.Domain().DeleteDnsRecord(zoneName, id); err != nil {
-					log.Errorf("Error deleting old record: %v", err)
-					return err
+				// Perform the required action (CREATE, UPDATE, DELETE).
+				switch change.Action {
+				case uthoCreate:
+					log.Infof("Creating record: %+v", change.ResourceRecordSet)
+					if err := p.client.CreateDnsRecord(change.ResourceRecordSet); err != nil {
+						log.Errorf("Error creating record: %v", err)
+						return err
+					}
+				case uthoDelete:
+					log.Infof("Deleting record: %+v", change.ResourceRecordSet)
+					record, err := lookupRecord(recordIndex, change.ResourceRecordSet)
+					if err != nil {
+						log.Errorf("Error getting record ID: %v", err)
+						return err
+					}
+
+					if err := p.client.DeleteDnsRecord(zoneName, record.ID); err != nil {
+						log.Errorf("Error deleting record: %v", err)
+						return err
+					}
+				case uthoUpdate:
+					record, err := lookupRecord(recordIndex, change.ResourceRecordSet)
+					if err != nil {
+						log.Errorf("Error getting record ID for update: %v", err)
+						return err
+					}
+
+					// Update the record in place instead of deleting and
+					// recreating it, so the record never briefly disappears.
+					log.Infof("Updating record: ID=%s, record=%+v", record.ID, change.ResourceRecordSet)
+					if err := p.client.UpdateDnsRecord(zoneName, record.ID, change.ResourceRecordSet); err != nil {
+						log.Errorf("Error updating record: %v", err)
+						return err
+					}
 				}
+			}
 
-				log.Infof("Creating updated record: %+v", change.ResourceRecordSet)
-				if _, err := p.client.Domain().CreateDnsRecord(change.ResourceRecordSet); err != nil {
-					log.Errorf("Error creating updated record: %v", err)
-					return err
+			// Pace batches so BatchChangeSize doubles as a real rate limit
+			// against the Utho API rather than just a grouping boundary.
+			// Skipped for the last batch (nothing left to wait for) and for
+			// dry runs (no API pressure to pace).
+			if !p.DryRun && p.BatchInterval > 0 && i < len(batches)-1 {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(p.BatchInterval):
 				}
 			}
 		}
+
+		if p.DryRun && len(zoneDiff.Records) > 0 {
+			zoneDiffs = append(zoneDiffs, zoneDiff)
+		}
+	}
+
+	if p.DryRun {
+		log.Infof("Dry run: computed plan: %+v", zoneDiffs)
+		p.setLastPlan(zoneDiffs)
 	}
 	return nil
 }
 
+// diffChange computes the RecordDiff that change would produce, using
+// recordIndex to resolve the record's prior value/TTL/ID for UPDATE and
+// DELETE actions.
+func diffChange(recordIndex map[recordIndexKey][]utho.DnsRecord, change *UthoChanges) (RecordDiff, error) {
+	rrset := change.ResourceRecordSet
+	newTTL, _ := strconv.Atoi(rrset.TTL)
+
+	diff := RecordDiff{
+		Hostname: rrset.Hostname,
+		Type:     rrset.Type,
+		NewTTL:   newTTL,
+	}
+
+	switch change.Action {
+	case uthoCreate:
+		diff.Action = RecordDiffAdded
+		diff.NewValue = formatRecordParamsValue(rrset)
+	case uthoDelete:
+		record, err := lookupRecord(recordIndex, rrset)
+		if err != nil {
+			return RecordDiff{}, err
+		}
+		oldTTL, _ := strconv.Atoi(record.TTL)
+		diff.Action = RecordDiffRemoved
+		diff.RecordID = record.ID
+		diff.OldTTL = oldTTL
+		diff.NewTTL = 0
+		oldValue, err := formatRecordValue(record)
+		if err != nil {
+			return RecordDiff{}, err
+		}
+		diff.OldValue = oldValue
+	case uthoUpdate:
+		record, err := lookupRecord(recordIndex, rrset)
+		if err != nil {
+			return RecordDiff{}, err
+		}
+		oldTTL, _ := strconv.Atoi(record.TTL)
+		diff.Action = RecordDiffChanged
+		diff.RecordID = record.ID
+		diff.OldTTL = oldTTL
+		diff.NewValue = formatRecordParamsValue(rrset)
+		oldValue, err := formatRecordValue(record)
+		if err != nil {
+			return RecordDiff{}, err
+		}
+		diff.OldValue = oldValue
+	}
+
+	return diff, nil
+}
+
+// chunkChanges splits changes into batches of at most size, preserving
+// order, so a large reconcile is submitted to a zone in bounded batches.
+func chunkChanges(changes []*UthoChanges, size int) [][]*UthoChanges {
+	if size <= 0 {
+		size = defaultBatchChangeSize
+	}
+
+	var chunks [][]*UthoChanges
+	for size < len(changes) {
+		changes, chunks = changes[size:], append(chunks, changes[:size:size])
+	}
+	chunks = append(chunks, changes)
+	return chunks
+}
+
+// recordIndexKey identifies a DNS record within a zone by its stored
+// hostname and type.
+type recordIndexKey struct {
+	hostname   string
+	recordType string
+}
+
+// buildRecordIndex builds a hostname+type lookup for a zone's records so a
+// record's ID and prior value can be resolved without a ListDnsRecords call
+// per change. Multiple records can share a hostname+type (the multi-target
+// fan-out in newUthoChanges produces exactly this), so each key holds an
+// ordered slice of candidates rather than a single record; lookupRecord
+// consumes one candidate at a time.
+func buildRecordIndex(records []utho.DnsRecord) map[recordIndexKey][]utho.DnsRecord {
+	index := make(map[recordIndexKey][]utho.DnsRecord, len(records))
+	for _, r := range records {
+		key := recordIndexKey{hostname: r.Hostname, recordType: r.Type}
+		index[key] = append(index[key], r)
+	}
+	return index
+}
+
+// lookupRecord resolves and consumes the record matching rrset's hostname
+// and type within a zone's cached record index, so that fanned-out changes
+// targeting the same hostname+type never resolve to the same record twice.
+// It prefers an exact value match (the case for DELETE, whose rrset still
+// carries the stored value); otherwise, as for an UPDATE, whose rrset
+// carries the new value, it falls back to the next remaining candidate in
+// ListDnsRecords order, which is the best available signal once the value
+// itself has changed.
+func lookupRecord(index map[recordIndexKey][]utho.DnsRecord, rrset utho.CreateDnsRecordParams) (utho.DnsRecord, error) {
+	key := recordIndexKey{hostname: rrset.Hostname, recordType: rrset.Type}
+	candidates := index[key]
+	if len(candidates) == 0 {
+		return utho.DnsRecord{}, fmt.Errorf("no record was found")
+	}
+
+	for i, candidate := range candidates {
+		if candidate.Value == rrset.Value {
+			index[key] = append(candidates[:i:i], candidates[i+1:]...)
+			return candidate, nil
+		}
+	}
+
+	record := candidates[0]
+	index[key] = candidates[1:]
+	return record, nil
+}
+
 // ApplyChanges consolidates changes and applies them to the DNS records.
 func (p *UthoProvider) ApplyChanges(ctx context.Context, changes *plan.Changes) error {
 	log.Infof("Applying changes: %v", changes)
+
+	if p.withinMinSyncInterval() {
+		log.Debugf("Skipping reconcile: min-sync-interval of %s has not elapsed", p.MinSyncInterval)
+		noopRunsTotal.Inc()
+		return nil
+	}
+
+	// Resolve the (cached) zone list up front and bail out before touching
+	// ListDnsRecords or CreateDnsRecord/etc. if the plan doesn't concern any
+	// zone we manage.
+	zones, err := p.cachedZones(ctx)
+	if err != nil {
+		log.Errorf("Error fetching zones during apply: %v", err)
+		return err
+	}
+
+	zoneIDName := provider.ZoneIDName{}
+	for _, z := range zones {
+		zoneIDName.Add(z.Domain, z.Domain)
+	}
+	p.zoneIDNameMapper = zoneIDName
+
+	// DS-typed endpoints don't describe a DNS record at all; they describe
+	// registrar-level DNSSEC state, so pull them out before the normal
+	// record-write path ever sees them.
+	dnssecChanges, changes := extractDNSSECChanges(changes)
+
+	if !hasInScopeChanges(zoneIDName, changes) && len(dnssecChanges) == 0 {
+		log.Info("No in-scope changes in this plan, skipping reconcile")
+		noopRunsTotal.Inc()
+		return nil
+	}
+
+	if _, err := p.applyDNSSECChanges(dnssecChanges, zoneIDName); err != nil {
+		return err
+	}
+
 	combinedChanges := make([]*UthoChanges, 0, len(changes.Create)+len(changes.UpdateNew)+len(changes.Delete))
 
-	// Append CREATE, UPDATE, DELETE changes to a unified list.
-	combinedChanges = append(combinedChanges, newUthoChanges(uthoCreate, changes.Create)...)
-	combinedChanges = append(combinedChanges, newUthoChanges(uthoUpdate, changes.UpdateNew)...)
-	combinedChanges = append(combinedChanges, newUthoChanges(uthoDelete, changes.Delete)...)
+	createChanges, err := newUthoChanges(uthoCreate, changes.Create)
+	if err != nil {
+		log.Errorf("Error building %s changes: %v", uthoCreate, err)
+		return err
+	}
+	combinedChanges = append(combinedChanges, createChanges...)
+
+	// UpdateOld/UpdateNew can't just be fanned into one UPDATE per new
+	// target: their target counts may themselves differ (an LB gaining or
+	// losing an IP, a multi-value TXT/CNAME set shrinking), so the delta
+	// needs explicit CREATE/DELETE rather than an UPDATE with no record to
+	// resolve, or a leaked orphaned record.
+	updateChanges, err := newUpdateChanges(changes.UpdateOld, changes.UpdateNew)
+	if err != nil {
+		log.Errorf("Error building %s changes: %v", uthoUpdate, err)
+		return err
+	}
+	combinedChanges = append(combinedChanges, updateChanges...)
+
+	deleteChanges, err := newUthoChanges(uthoDelete, changes.Delete)
+	if err != nil {
+		log.Errorf("Error building %s changes: %v", uthoDelete, err)
+		return err
+	}
+	combinedChanges = append(combinedChanges, deleteChanges...)
+
+	if err := p.submitChanges(ctx, zones, combinedChanges); err != nil {
+		return err
+	}
 
-	return p.submitChanges(ctx, combinedChanges)
+	p.markSynced()
+	return nil
 }
 
-// newUthoChanges constructs UthoChanges from a list of endpoints.
-func newUthoChanges(action string, endpoints []*endpoint.Endpoint) []*UthoChanges {
+// newUthoChanges constructs UthoChanges from a list of endpoints. Endpoints
+// with multiple targets are fanned out into one UthoChanges per target so
+// that no target is silently dropped, and record-type specific fields (MX,
+// SRV, CAA, TXT) are parsed out of the target string.
+func newUthoChanges(action string, endpoints []*endpoint.Endpoint) ([]*UthoChanges, error) {
 	log.Infof("Creating new Utho changes: action=%s, endpoints=%v", action, endpoints)
 	changes := make([]*UthoChanges, 0, len(endpoints))
-	ttl := uthoTTL
 	for _, e := range endpoints {
-		// Use custom TTL if configured, otherwise use default.
-		if e.RecordTTL.IsConfigured() {
-			ttl = int(e.RecordTTL)
+		log.Debugf("Processing endpoint: %v", e)
+		endpointChanges, err := newUthoChangesForTargets(action, e, e.Targets)
+		if err != nil {
+			return nil, err
 		}
+		changes = append(changes, endpointChanges...)
+	}
+	return changes, nil
+}
 
-		log.Debugf("Processing endpoint: %v", e)
-		change := &UthoChanges{
-			Action: action,
-			ResourceRecordSet: utho.CreateDnsRecordParams{
-				Type:     e.RecordType,
-				Hostname: e.DNSName,
-				Value:    e.Targets[0],
-				TTL:      strconv.Itoa(ttl),
-			},
+// newUpdateChanges reconciles an UPDATE endpoint pair into explicit
+// CREATE/UPDATE/DELETE UthoChanges. A naive one-UPDATE-per-new-target fan
+// out assumes old and new have the same target count, which doesn't hold
+// for a multi-value endpoint whose target count itself changes (an LB
+// gaining or losing an IP, a multi-value TXT/CNAME set shrinking): a
+// shrink would leave the now-unmatched old record never deleted, and a
+// grow would leave an UPDATE with no old record to resolve it against.
+// Targets with a 1:1 old/new correspondence are updated in place; any
+// extra old targets are deleted and any extra new targets are created.
+// oldEndpoints and newEndpoints must be the same length and pairwise
+// correspond to the same endpoint identity, matching the guarantee
+// external-dns's planner makes for plan.Changes' UpdateOld/UpdateNew.
+func newUpdateChanges(oldEndpoints, newEndpoints []*endpoint.Endpoint) ([]*UthoChanges, error) {
+	if len(oldEndpoints) != len(newEndpoints) {
+		return nil, fmt.Errorf("UpdateOld/UpdateNew length mismatch: %d != %d", len(oldEndpoints), len(newEndpoints))
+	}
+
+	var changes []*UthoChanges
+	for i, newEp := range newEndpoints {
+		oldEp := oldEndpoints[i]
+
+		matched := len(oldEp.Targets)
+		if len(newEp.Targets) < matched {
+			matched = len(newEp.Targets)
+		}
+
+		updated, err := newUthoChangesForTargets(uthoUpdate, newEp, newEp.Targets[:matched])
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, updated...)
+
+		if len(oldEp.Targets) > matched {
+			deleted, err := newUthoChangesForTargets(uthoDelete, oldEp, oldEp.Targets[matched:])
+			if err != nil {
+				return nil, err
+			}
+			changes = append(changes, deleted...)
+		}
+
+		if len(newEp.Targets) > matched {
+			created, err := newUthoChangesForTargets(uthoCreate, newEp, newEp.Targets[matched:])
+			if err != nil {
+				return nil, err
+			}
+			changes = append(changes, created...)
+		}
+	}
+	return changes, nil
+}
+
+// newUthoChangesForTargets builds one UthoChanges per target in targets for
+// endpoint e, parsing record-type specific fields (MX, SRV, CAA, TXT) out of
+// each target string.
+func newUthoChangesForTargets(action string, e *endpoint.Endpoint, targets endpoint.Targets) ([]*UthoChanges, error) {
+	ttl := uthoTTL
+	// Use custom TTL if configured, otherwise use default.
+	if e.RecordTTL.IsConfigured() {
+		ttl = int(e.RecordTTL)
+	}
+
+	changes := make([]*UthoChanges, 0, len(targets))
+	for _, target := range targets {
+		rrset := utho.CreateDnsRecordParams{
+			Type:     e.RecordType,
+			Hostname: e.DNSName,
+			TTL:      strconv.Itoa(ttl),
+		}
+
+		if err := populateRecordParams(&rrset, e.RecordType, target); err != nil {
+			return nil, fmt.Errorf("endpoint %s: %w", e.DNSName, err)
+		}
+
+		changes = append(changes, &UthoChanges{
+			Action:            action,
+			ResourceRecordSet: rrset,
+		})
+	}
+	return changes, nil
+}
+
+// populateRecordParams fills in the type-specific fields of rrset from a
+// single target string, splitting MX, SRV and CAA targets into their
+// component fields and escaping TXT targets per RFC 1035.
+func populateRecordParams(rrset *utho.CreateDnsRecordParams, recordType, target string) error {
+	switch recordType {
+	case endpoint.RecordTypeMX:
+		priority, host, err := parseMXTarget(target)
+		if err != nil {
+			return err
+		}
+		rrset.Priority = priority
+		rrset.Value = host
+	case "SRV":
+		priority, weight, port, srvTarget, err := parseSRVTarget(target)
+		if err != nil {
+			return err
+		}
+		rrset.Priority = priority
+		rrset.Weight = weight
+		rrset.Port = port
+		rrset.Value = srvTarget
+	case "CAA":
+		flag, tag, value, err := parseCAATarget(target)
+		if err != nil {
+			return err
+		}
+		rrset.Flag = flag
+		rrset.Tag = tag
+		rrset.Value = value
+	case endpoint.RecordTypeTXT:
+		rrset.Value = encodeTXTValue(target)
+	default:
+		rrset.Value = target
+	}
+	return nil
+}
+
+// parseMXTarget splits an MX target of the form "<priority> <host>" into its
+// priority and host components.
+func parseMXTarget(target string) (priority, host string, err error) {
+	fields := strings.Fields(target)
+	if len(fields) != 2 {
+		return "", "", fmt.Errorf("invalid MX target %q: expected \"<priority> <host>\"", target)
+	}
+	if _, err := strconv.Atoi(fields[0]); err != nil {
+		return "", "", fmt.Errorf("invalid MX priority in target %q: %w", target, err)
+	}
+	return fields[0], fields[1], nil
+}
+
+// parseSRVTarget splits an SRV target of the form
+// "<priority> <weight> <port> <target>" into its component fields.
+func parseSRVTarget(target string) (priority, weight, port, srvTarget string, err error) {
+	fields := strings.Fields(target)
+	if len(fields) != 4 {
+		return "", "", "", "", fmt.Errorf("invalid SRV target %q: expected \"<priority> <weight> <port> <target>\"", target)
+	}
+	for _, f := range fields[:3] {
+		if _, err := strconv.Atoi(f); err != nil {
+			return "", "", "", "", fmt.Errorf("invalid SRV numeric field in target %q: %w", target, err)
+		}
+	}
+	return fields[0], fields[1], fields[2], fields[3], nil
+}
+
+// parseCAATarget splits a CAA target of the form "<flag> <tag> \"<value>\""
+// into its component fields.
+func parseCAATarget(target string) (flag, tag, value string, err error) {
+	fields := strings.SplitN(target, " ", 3)
+	if len(fields) != 3 {
+		return "", "", "", fmt.Errorf("invalid CAA target %q: expected \"<flag> <tag> <value>\"", target)
+	}
+	if _, err := strconv.Atoi(fields[0]); err != nil {
+		return "", "", "", fmt.Errorf("invalid CAA flag in target %q: %w", target, err)
+	}
+	unquoted, err := strconv.Unquote(fields[2])
+	if err != nil {
+		// Value wasn't quoted; use it verbatim.
+		unquoted = fields[2]
+	}
+	return fields[0], fields[1], unquoted, nil
+}
+
+// txtChunkSize is the maximum length of a single DNS character-string, per
+// RFC 1035 section 3.3.
+const txtChunkSize = 255
+
+// encodeTXTValue quotes a TXT target and, if it is longer than a single
+// character-string allows, splits it into multiple quoted character-strings
+// per RFC 1035.
+func encodeTXTValue(raw string) string {
+	if len(raw) <= txtChunkSize {
+		return strconv.Quote(raw)
+	}
+
+	var chunks []string
+	for len(raw) > 0 {
+		end := txtChunkSize
+		if end > len(raw) {
+			end = len(raw)
+		}
+		chunks = append(chunks, strconv.Quote(raw[:end]))
+		raw = raw[end:]
+	}
+	return strings.Join(chunks, " ")
+}
+
+// decodeTXTValue reverses encodeTXTValue, scanning stored for one or more
+// double-quoted character-strings and concatenating their unquoted content
+// back into the original TXT value. It scans quote boundaries rather than
+// splitting on whitespace, since a character-string's content (e.g. an SPF
+// record) commonly contains internal spaces that strings.Fields would
+// otherwise tear apart.
+func decodeTXTValue(stored string) string {
+	var sb strings.Builder
+	for i := 0; i < len(stored); {
+		if stored[i] != '"' {
+			i++
+			continue
 		}
 
-		changes = append(changes, change)
+		j := i + 1
+		for j < len(stored) {
+			if stored[j] == '\\' && j+1 < len(stored) {
+				j += 2
+				continue
+			}
+			if stored[j] == '"' {
+				break
+			}
+			j++
+		}
+		if j >= len(stored) {
+			break
+		}
+
+		if unquoted, err := strconv.Unquote(stored[i : j+1]); err == nil {
+			sb.WriteString(unquoted)
+		}
+		i = j + 1
 	}
-	return changes
+	return sb.String()
 }
 
 // separateChangesByZone organizes changes into zones for batch processing.
@@ -304,39 +1170,23 @@ func separateChangesByZone(zones []utho.Domain, changes []*UthoChanges) map[stri
 	return change
 }
 
-// getRecordID retrieves the ID of a specific DNS record in a zone.
-func (p *UthoProvider) getRecordID(zone string, record utho.CreateDnsRecordParams) (recordID string, err error) {
-	log.Infof("Fetching record ID for zone: %s, record: %+v", zone, record)
-	records, err := p.client.Domain().ListDnsRecords(zone)
-	if err != nil {
-		log.Errorf("Error fetching records for zone %s: %v", zone, err)
-		return "0", err
-	}
-
-	// Find the record by matching its hostname and type.
-	for _, r := range records {
-		log.Debugf("Checking record: %+v", r)
-		strippedName := strings.TrimSuffix(record.Hostname, "."+zone)
-		if record.Hostname == zone {
-			strippedName = ""
-		}
-
-		if r.Hostname == strippedName && r.Type == record.Type {
-			log.Infof("Found matching record ID: %s", r.ID)
-			return r.ID, nil
-		}
-	}
-
-	log.Warnf("No record found for zone: %s, record: %+v", zone, record)
-	return "", fmt.Errorf("no record was found")
-}
-
-// AdjustEndpoints ensures endpoints conform to the zone's requirements.
+// AdjustEndpoints ensures endpoints conform to the zone's requirements. This
+// is also the hook external-dns's planner consults before diffing current
+// and desired state, so it is where DS-typed endpoints are dropped when
+// DNSSEC support hasn't been opted into via UTHO_ENABLE_DNSSEC — the
+// same capability-declaration role providers.CanAutoDNSSEC/CanUseDS play in
+// other DNS providers, rather than a private filter inside ApplyChanges
+// alone.
 func (p *UthoProvider) AdjustEndpoints(endpoints []*endpoint.Endpoint) ([]*endpoint.Endpoint, error) {
 	log.Infof("Adjusting endpoints: %v", endpoints)
 	adjustedEndpoints := []*endpoint.Endpoint{}
 
 	for _, ep := range endpoints {
+		if ep.RecordType == "DS" && !p.DNSSECEnabled {
+			log.Debugf("Dropping DS endpoint %s: DNSSEC support is disabled (UTHO_ENABLE_DNSSEC)", ep.DNSName)
+			continue
+		}
+
 		log.Debugf("Adjusting endpoint: %v", ep)
 		_, zoneName := p.zoneIDNameMapper.FindZone(ep.DNSName)
 		adjustedTargets := endpoint.Targets{}